@@ -0,0 +1,141 @@
+// Package apollotracing implements a graphql.Tracer that records
+// resolver-level timing in the Apollo Tracing v1 format
+// (https://github.com/apollographql/apollo-tracing), for opt-in inclusion
+// under extensions.tracing on the response.
+package apollotracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Tracer is a graphql.Tracer that accumulates an Apollo Tracing payload for
+// the lifetime of a single operation.
+type Tracer struct{}
+
+var _ graphql.Tracer = Tracer{}
+
+type key string
+
+const trackerKey key = "apollotracing-tracker"
+const fieldStartKey key = "apollotracing-field-start"
+
+type tracker struct {
+	mu sync.Mutex
+
+	start time.Time
+	end   time.Time
+
+	parsingStart    time.Time
+	validationStart time.Time
+	executionStart  time.Time
+
+	resolvers []*ResolverExecution
+}
+
+func getTracker(ctx context.Context) *tracker {
+	t, _ := ctx.Value(trackerKey).(*tracker)
+	return t
+}
+
+// ResolverExecution is one entry of the Apollo "execution.resolvers" array.
+type ResolverExecution struct {
+	Path        []interface{} `json:"path"`
+	ParentType  string        `json:"parentType"`
+	FieldName   string        `json:"fieldName"`
+	ReturnType  string        `json:"returnType"`
+	StartOffset int64         `json:"startOffset"`
+	Duration    int64         `json:"duration"`
+}
+
+func (Tracer) StartOperationParsing(ctx context.Context) context.Context {
+	now := time.Now()
+	t := &tracker{start: now, parsingStart: now}
+	return context.WithValue(ctx, trackerKey, t)
+}
+
+func (Tracer) StartOperationValidation(ctx context.Context) context.Context {
+	if t := getTracker(ctx); t != nil {
+		t.validationStart = time.Now()
+	}
+	return ctx
+}
+
+func (Tracer) StartOperationExecution(ctx context.Context) context.Context {
+	if t := getTracker(ctx); t != nil {
+		t.executionStart = time.Now()
+	}
+	return ctx
+}
+
+func (Tracer) StartFieldExecution(ctx context.Context, rc *graphql.ResolverContext) context.Context {
+	return context.WithValue(ctx, fieldStartKey, time.Now())
+}
+
+func (Tracer) StartFieldResolverExecution(ctx context.Context, rc *graphql.ResolverContext) context.Context {
+	return ctx
+}
+
+func (Tracer) EndFieldExecution(ctx context.Context) {
+	t := getTracker(ctx)
+	rc := graphql.GetResolverContext(ctx)
+	fieldStart, ok := ctx.Value(fieldStartKey).(time.Time)
+	if t == nil || rc == nil || !ok {
+		return
+	}
+
+	entry := &ResolverExecution{
+		Path:        rc.Path(),
+		ParentType:  rc.Object,
+		FieldName:   rc.Field.Name,
+		ReturnType:  rc.ReturnType,
+		StartOffset: fieldStart.Sub(t.start).Nanoseconds(),
+		Duration:    time.Since(fieldStart).Nanoseconds(),
+	}
+
+	t.mu.Lock()
+	t.resolvers = append(t.resolvers, entry)
+	t.mu.Unlock()
+}
+
+func (Tracer) EndOperationExecution(ctx context.Context) {
+	if t := getTracker(ctx); t != nil {
+		t.end = time.Now()
+	}
+}
+
+// Extension returns the Apollo Tracing v1 payload accumulated for ctx's
+// operation, for attaching at Response.Extensions["tracing"]. It returns
+// nil if the operation was never traced.
+func (Tracer) Extension(ctx context.Context) map[string]interface{} {
+	t := getTracker(ctx)
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	resolvers := make([]*ResolverExecution, len(t.resolvers))
+	copy(resolvers, t.resolvers)
+	t.mu.Unlock()
+
+	return map[string]interface{}{
+		"version":   1,
+		"startTime": t.start.Format(time.RFC3339Nano),
+		"endTime":   t.end.Format(time.RFC3339Nano),
+		"duration":  t.end.Sub(t.start).Nanoseconds(),
+		"parsing": map[string]interface{}{
+			"startOffset": t.parsingStart.Sub(t.start).Nanoseconds(),
+			"duration":    t.validationStart.Sub(t.parsingStart).Nanoseconds(),
+		},
+		"validation": map[string]interface{}{
+			"startOffset": t.validationStart.Sub(t.start).Nanoseconds(),
+			"duration":    t.executionStart.Sub(t.validationStart).Nanoseconds(),
+		},
+		"execution": map[string]interface{}{
+			"resolvers": resolvers,
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package apollotracing
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestTracerRecordsFieldTimingConcurrently(t *testing.T) {
+	tr := Tracer{}
+	reqCtx := graphql.NewRequestContext(nil, "{ a b c d }", nil)
+	reqCtx.Tracer = tr
+
+	ctx := tr.StartOperationParsing(context.Background())
+	ctx = tr.StartOperationValidation(ctx)
+	ctx = tr.StartOperationExecution(ctx)
+
+	fields := []string{"a", "b", "c", "d"}
+	var wg sync.WaitGroup
+	for _, name := range fields {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			field := graphql.CollectedField{Name: name, Alias: name}
+			rc := &graphql.ResolverContext{Object: "Query", Field: field, ReturnType: "String"}
+			_, _ = field.InvokeField(ctx, reqCtx, rc, nil, func(ctx context.Context) (interface{}, error) {
+				return name, nil
+			})
+		}(name)
+	}
+	wg.Wait()
+
+	tr.EndOperationExecution(ctx)
+
+	ext := tr.Extension(ctx)
+	if ext == nil {
+		t.Fatal("expected a non-nil tracing extension")
+	}
+	if ext["version"] != 1 {
+		t.Fatalf("expected version 1, got %v", ext["version"])
+	}
+
+	execution, ok := ext["execution"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected execution to be a map, got %+v", ext["execution"])
+	}
+	resolvers, ok := execution["resolvers"].([]*ResolverExecution)
+	if !ok {
+		t.Fatalf("expected execution.resolvers to be a []*ResolverExecution, got %+v", execution["resolvers"])
+	}
+	if len(resolvers) != len(fields) {
+		t.Fatalf("expected %d resolver entries from %d concurrent fields, got %d", len(fields), len(fields), len(resolvers))
+	}
+	for _, r := range resolvers {
+		if r.FieldName == "" {
+			t.Fatalf("expected every resolver entry to carry a field name, got %+v", r)
+		}
+	}
+}
+
+func TestExtensionNilWithoutTracker(t *testing.T) {
+	tr := Tracer{}
+	if ext := tr.Extension(context.Background()); ext != nil {
+		t.Fatalf("expected a nil extension for a context that was never traced, got %+v", ext)
+	}
+}
@@ -0,0 +1,82 @@
+// Package apq implements Automatic Persisted Queries: after a client's
+// first request registers a query's text under its SHA-256 hash, later
+// requests can send just the hash, saving the query text's bandwidth.
+//
+// Resolve is meant to run upstream of parsing, before graphql.Execute, but
+// there is no HTTP handler in this tree to call it from a live request yet
+// ("wire the cache option into the handler", from the original request) —
+// that wiring is follow-up work for whichever package ends up owning the
+// HTTP transport.
+package apq
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// QueryCache stores query text by its SHA-256 hash. Get/Add take a context
+// so a Redis-backed (or any other remote) implementation can do its I/O
+// through it. Implementations must be safe for concurrent use.
+type QueryCache interface {
+	Get(ctx context.Context, hash string) (string, bool)
+	Add(ctx context.Context, hash, query string)
+}
+
+// Extension is the shape of extensions.persistedQuery on an incoming
+// request.
+type Extension struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
+}
+
+// ParseExtension extracts the persistedQuery extension from a request's raw
+// extensions map, returning a nil Extension if the client isn't using APQ.
+func ParseExtension(extensions map[string]json.RawMessage) (*Extension, error) {
+	raw, ok := extensions["persistedQuery"]
+	if !ok {
+		return nil, nil
+	}
+
+	var ext Extension
+	if err := json.Unmarshal(raw, &ext); err != nil {
+		return nil, err
+	}
+	return &ext, nil
+}
+
+// Resolve implements the APQ protocol upstream of CollectFields: given the
+// query text sent on the request (empty if the client is relying on a
+// previously registered hash) and its persistedQuery extension (nil if the
+// client isn't using APQ), it returns the query text to parse.
+//
+// If resp is non-nil, callers must return it to the client unchanged and
+// must not proceed to parsing/CollectFields.
+func Resolve(ctx context.Context, cache QueryCache, query string, ext *Extension) (resolved string, resp *graphql.Response) {
+	if ext == nil {
+		return query, nil
+	}
+
+	if query == "" {
+		cached, ok := cache.Get(ctx, ext.Sha256Hash)
+		if !ok {
+			return "", graphql.ErrorResponse(ctx, "PersistedQueryNotFound")
+		}
+		return cached, nil
+	}
+
+	if !verifyHash(ext.Sha256Hash, query) {
+		return "", graphql.ErrorResponse(ctx, "provided sha256Hash does not match query")
+	}
+
+	cache.Add(ctx, ext.Sha256Hash, query)
+	return query, nil
+}
+
+func verifyHash(hash, query string) bool {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:]) == hash
+}
@@ -0,0 +1,87 @@
+package apq
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func hashOf(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestResolveNoExtension(t *testing.T) {
+	resolved, resp := Resolve(context.Background(), NewLRUCache(10), "{ hello }", nil)
+	if resp != nil {
+		t.Fatalf("expected no response, got %+v", resp)
+	}
+	if resolved != "{ hello }" {
+		t.Fatalf("expected query returned unchanged, got %q", resolved)
+	}
+}
+
+func TestResolveNotFound(t *testing.T) {
+	cache := NewLRUCache(10)
+	_, resp := Resolve(context.Background(), cache, "", &Extension{Version: 1, Sha256Hash: hashOf("{ hello }")})
+	if resp == nil || len(resp.Errors) != 1 || resp.Errors[0].Message != "PersistedQueryNotFound" {
+		t.Fatalf("expected a PersistedQueryNotFound response, got %+v", resp)
+	}
+}
+
+func TestResolveHashMismatch(t *testing.T) {
+	cache := NewLRUCache(10)
+	_, resp := Resolve(context.Background(), cache, "{ hello }", &Extension{Version: 1, Sha256Hash: hashOf("{ goodbye }")})
+	if resp == nil || len(resp.Errors) != 1 {
+		t.Fatalf("expected a hash-mismatch response, got %+v", resp)
+	}
+}
+
+func TestResolveRegistersAndReuses(t *testing.T) {
+	cache := NewLRUCache(10)
+	ctx := context.Background()
+	query := "{ hello }"
+	hash := hashOf(query)
+
+	resolved, resp := Resolve(ctx, cache, query, &Extension{Version: 1, Sha256Hash: hash})
+	if resp != nil {
+		t.Fatalf("expected the first request carrying the query to succeed, got %+v", resp)
+	}
+	if resolved != query {
+		t.Fatalf("expected resolved query %q, got %q", query, resolved)
+	}
+
+	resolved, resp = Resolve(ctx, cache, "", &Extension{Version: 1, Sha256Hash: hash})
+	if resp != nil {
+		t.Fatalf("expected the hash-only follow-up request to hit the cache, got %+v", resp)
+	}
+	if resolved != query {
+		t.Fatalf("expected cached query %q, got %q", query, resolved)
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	cache := NewLRUCache(2)
+	ctx := context.Background()
+
+	cache.Add(ctx, "a", "queryA")
+	cache.Add(ctx, "b", "queryB")
+
+	// touch "a" so "b" becomes the least recently used entry
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Fatal("expected queryA to be present")
+	}
+
+	cache.Add(ctx, "c", "queryC")
+
+	if _, ok := cache.Get(ctx, "b"); ok {
+		t.Fatal("expected queryB to have been evicted as the least recently used entry")
+	}
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Fatal("expected queryA to still be cached")
+	}
+	if _, ok := cache.Get(ctx, "c"); !ok {
+		t.Fatal("expected queryC to be cached")
+	}
+}
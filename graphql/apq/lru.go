@@ -0,0 +1,67 @@
+package apq
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// LRUCache is an in-memory QueryCache that evicts the least recently used
+// query once it holds more than size entries.
+type LRUCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type lruEntry struct {
+	hash  string
+	query string
+}
+
+// NewLRUCache creates an LRUCache holding at most size queries.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:  size,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+var _ QueryCache = (*LRUCache)(nil)
+
+func (c *LRUCache) Get(ctx context.Context, hash string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[hash]
+	if !ok {
+		return "", false
+	}
+
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruEntry).query, true
+}
+
+func (c *LRUCache) Add(ctx context.Context, hash, query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[hash]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).query = query
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{hash: hash, query: query})
+	c.items[hash] = el
+
+	if c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).hash)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Package complexity estimates the cost of a GraphQL operation before it
+// runs, so that ExecutableSchema implementations can reject queries that
+// would be too expensive to serve.
+package complexity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// FieldComplexityFunc computes the cost of a field given the combined
+// complexity of its child selections and the field's bound arguments. A
+// list field can use args to scale its cost, e.g. multiplying
+// childComplexity by a "first" argument.
+type FieldComplexityFunc func(childComplexity int, args map[string]interface{}) int
+
+// Estimator walks the selection set of an operation and sums the cost of
+// every field, using registered FieldComplexityFuncs where available and
+// DefaultComplexity everywhere else.
+type Estimator struct {
+	Schema *ast.Schema
+
+	// MaxDepth rejects operations nested deeper than this many levels. Zero
+	// means unlimited.
+	MaxDepth int
+
+	// DefaultComplexity is the cost charged for a field with no registered
+	// FieldComplexityFunc.
+	DefaultComplexity int
+
+	funcs map[string]FieldComplexityFunc
+}
+
+func NewEstimator(schema *ast.Schema) *Estimator {
+	return &Estimator{
+		Schema:            schema,
+		DefaultComplexity: 1,
+		funcs:             map[string]FieldComplexityFunc{},
+	}
+}
+
+// SetFieldComplexity registers fn as the cost function for typeName.field.
+func (e *Estimator) SetFieldComplexity(typeName, field string, fn FieldComplexityFunc) {
+	e.funcs[typeName+"."+field] = fn
+}
+
+// Complexity returns the total cost of op, or an error if it is nested
+// deeper than MaxDepth.
+func (e *Estimator) Complexity(reqCtx *graphql.RequestContext, op *ast.OperationDefinition) (int, error) {
+	var rootType string
+	switch op.Operation {
+	case ast.Query:
+		rootType = e.Schema.Query.Name
+	case ast.Mutation:
+		rootType = e.Schema.Mutation.Name
+	case ast.Subscription:
+		rootType = e.Schema.Subscription.Name
+	}
+
+	return e.complexity(reqCtx, rootType, op.SelectionSet, 1)
+}
+
+// CheckLimit computes op's complexity against reqCtx, records it on
+// reqCtx.OperationComplexity, and returns a rejection Response if
+// reqCtx.ComplexityLimit is set and exceeded. Callers run this before
+// dispatching to Query/Mutation/Subscription and return the Response
+// unchanged if it is non-nil.
+func (e *Estimator) CheckLimit(ctx context.Context, reqCtx *graphql.RequestContext, op *ast.OperationDefinition) *graphql.Response {
+	cost, err := e.Complexity(reqCtx, op)
+	if err != nil {
+		return graphql.ErrorResponse(ctx, "%s", err.Error())
+	}
+	reqCtx.OperationComplexity = cost
+
+	if reqCtx.ComplexityLimit > 0 && cost > reqCtx.ComplexityLimit {
+		return graphql.ErrorResponse(ctx, "operation has complexity %d, which exceeds the limit of %d", cost, reqCtx.ComplexityLimit)
+	}
+
+	return nil
+}
+
+func (e *Estimator) complexity(reqCtx *graphql.RequestContext, typeName string, selSet ast.SelectionSet, depth int) (int, error) {
+	if e.MaxDepth > 0 && depth > e.MaxDepth {
+		return 0, fmt.Errorf("operation has depth %d, which exceeds the limit of %d", depth, e.MaxDepth)
+	}
+
+	def := e.Schema.Types[typeName]
+	satisfies := []string{typeName}
+	if def != nil {
+		satisfies = append(satisfies, def.Interfaces...)
+	}
+
+	// collectFields already merges fragment spreads and inline fragments and
+	// drops branches excluded by @skip/@include, so the estimator only ever
+	// sees fields that will actually execute.
+	fields := graphql.CollectFields(reqCtx, selSet, satisfies)
+
+	total := 0
+	for _, f := range fields {
+		fieldDef := lookupField(def, f.Name)
+		if fieldDef == nil {
+			continue // introspection fields (__typename etc) are free
+		}
+
+		childComplexity := 0
+		if len(f.Selections) > 0 {
+			c, err := e.complexity(reqCtx, fieldDef.Type.Name(), f.Selections, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			childComplexity = c
+		}
+
+		fn, ok := e.funcs[typeName+"."+f.Name]
+		if !ok {
+			total += e.DefaultComplexity + childComplexity
+			continue
+		}
+		total += fn(childComplexity, f.Args)
+	}
+
+	return total, nil
+}
+
+func lookupField(def *ast.Definition, name string) *ast.FieldDefinition {
+	if def == nil {
+		return nil
+	}
+	for _, f := range def.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
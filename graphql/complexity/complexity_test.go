@@ -0,0 +1,131 @@
+package complexity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vektah/gqlparser"
+	"github.com/vektah/gqlparser/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const schemaSrc = `
+type Query {
+  posts(first: Int): [Post!]!
+  me: User!
+}
+
+type Post {
+  id: ID!
+  title: String!
+  author: User!
+}
+
+type User {
+  id: ID!
+  name: String!
+}
+`
+
+func mustSchema(t *testing.T) *ast.Schema {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSrc})
+	if err != nil {
+		t.Fatalf("failed to load schema: %s", err)
+	}
+	return schema
+}
+
+func mustOperation(t *testing.T, schema *ast.Schema, query string) (*graphql.RequestContext, *ast.OperationDefinition) {
+	t.Helper()
+	doc, errs := gqlparser.LoadQuery(schema, query)
+	if len(errs) > 0 {
+		t.Fatalf("failed to load query: %s", errs)
+	}
+	return graphql.NewRequestContext(doc, query, nil), doc.Operations[0]
+}
+
+func TestComplexityDefault(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ me { id name } }`)
+
+	cost, err := NewEstimator(schema).Complexity(reqCtx, op)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// me(1) + id(1) + name(1)
+	if cost != 3 {
+		t.Fatalf("expected cost 3, got %d", cost)
+	}
+}
+
+func TestComplexityFieldMultiplier(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ posts(first: 10) { id title } }`)
+
+	e := NewEstimator(schema)
+	e.SetFieldComplexity("Query", "posts", func(childComplexity int, args map[string]interface{}) int {
+		first, _ := args["first"].(int64)
+		return int(first) * childComplexity
+	})
+
+	cost, err := e.Complexity(reqCtx, op)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// (id(1) + title(1)) * first:10
+	if cost != 20 {
+		t.Fatalf("expected cost 20, got %d", cost)
+	}
+}
+
+func TestComplexityMaxDepth(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ posts(first: 1) { author { id } } }`)
+
+	e := NewEstimator(schema)
+	e.MaxDepth = 2
+
+	if _, err := e.Complexity(reqCtx, op); err == nil {
+		t.Fatal("expected an error for an operation nested deeper than MaxDepth")
+	}
+}
+
+func TestComplexitySkipDirective(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ me { id name @skip(if: true) } }`)
+
+	cost, err := NewEstimator(schema).Complexity(reqCtx, op)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// me(1) + id(1); name is skipped
+	if cost != 2 {
+		t.Fatalf("expected cost 2, got %d", cost)
+	}
+}
+
+func TestCheckLimitRejectsOverBudget(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ me { id name } }`)
+	reqCtx.ComplexityLimit = 2
+
+	resp := NewEstimator(schema).CheckLimit(context.Background(), reqCtx, op)
+	if resp == nil || len(resp.Errors) == 0 {
+		t.Fatal("expected a rejection response for an operation over the complexity limit")
+	}
+	if reqCtx.OperationComplexity != 3 {
+		t.Fatalf("expected OperationComplexity to be recorded as 3, got %d", reqCtx.OperationComplexity)
+	}
+}
+
+func TestCheckLimitAllowsUnderBudget(t *testing.T) {
+	schema := mustSchema(t)
+	reqCtx, op := mustOperation(t, schema, `{ me { id name } }`)
+	reqCtx.ComplexityLimit = 10
+
+	if resp := NewEstimator(schema).CheckLimit(context.Background(), reqCtx, op); resp != nil {
+		t.Fatalf("expected no rejection response, got %+v", resp)
+	}
+}
@@ -0,0 +1,53 @@
+package complexity
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// LimitedSchema wraps an ExecutableSchema, rejecting any operation whose
+// estimated complexity exceeds the request's ComplexityLimit (or whose
+// depth exceeds Estimator.MaxDepth) before delegating to the wrapped
+// schema. Without a RequestContext on ctx, no check is made. Construct it around the schema passed
+// to graphql.Execute to enforce a limit without every resolver having to
+// call CheckLimit itself:
+//
+//	es = complexity.LimitedSchema{ExecutableSchema: es, Estimator: estimator}
+type LimitedSchema struct {
+	graphql.ExecutableSchema
+	Estimator *Estimator
+}
+
+var _ graphql.ExecutableSchema = LimitedSchema{}
+
+func (s LimitedSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if resp := s.checkLimit(ctx, op); resp != nil {
+		return resp
+	}
+	return s.ExecutableSchema.Query(ctx, op)
+}
+
+func (s LimitedSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if resp := s.checkLimit(ctx, op); resp != nil {
+		return resp
+	}
+	return s.ExecutableSchema.Mutation(ctx, op)
+}
+
+func (s LimitedSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	if resp := s.checkLimit(ctx, op); resp != nil {
+		return func() *graphql.Response { return resp }
+	}
+	return s.ExecutableSchema.Subscription(ctx, op)
+}
+
+func (s LimitedSchema) checkLimit(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	reqCtx := graphql.GetRequestContext(ctx)
+	if reqCtx == nil {
+		return nil
+	}
+	return s.Estimator.CheckLimit(ctx, reqCtx, op)
+}
@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// RequestContext carries the per-request state that is threaded through
+// collection and execution: the raw query, its parsed document, bound
+// variables, and anything resolvers need to look up along the way.
+type RequestContext struct {
+	RawQuery  string
+	Variables map[string]interface{}
+	Doc       *ast.QueryDocument
+	Operation *ast.OperationDefinition
+
+	// Directives holds the user-registered handlers for custom schema and
+	// query directives, keyed by directive name (e.g. "hasRole"). Built-in
+	// directives (@skip, @include) are handled separately by the collector
+	// and are never looked up here.
+	Directives map[string]DirectiveFunc
+
+	// ComplexityLimit is the maximum operation cost, as computed by
+	// graphql/complexity.Estimator, that will be allowed to execute. Zero
+	// means unlimited.
+	ComplexityLimit int
+	// OperationComplexity is the cost of the current operation, populated by
+	// the complexity estimator before execution so middleware can log or
+	// rate-limit on it.
+	OperationComplexity int
+
+	// Tracer receives lifecycle hooks as the operation is parsed, validated
+	// and executed. Defaults to NopTracer{}.
+	Tracer Tracer
+
+	Errors gqlerror.List
+}
+
+// NewRequestContext creates a RequestContext for a parsed operation, with
+// sane defaults for the optional hooks.
+func NewRequestContext(doc *ast.QueryDocument, query string, variables map[string]interface{}) *RequestContext {
+	return &RequestContext{
+		RawQuery:   query,
+		Variables:  variables,
+		Doc:        doc,
+		Directives: map[string]DirectiveFunc{},
+		Tracer:     NopTracer{},
+	}
+}
+
+// Error records err against the current request.
+func (c *RequestContext) Error(ctx context.Context, err error) {
+	c.Errors = append(c.Errors, gqlerror.Errorf("%s", err.Error()))
+}
+
+type requestCtxKey string
+
+const currentRequestCtxKey requestCtxKey = "request_context"
+
+// WithRequestContext returns a context carrying rc, for retrieval by
+// GetRequestContext further down the call stack.
+func WithRequestContext(ctx context.Context, rc *RequestContext) context.Context {
+	return context.WithValue(ctx, currentRequestCtxKey, rc)
+}
+
+// GetRequestContext returns the RequestContext stored in ctx, or nil if
+// there isn't one.
+func GetRequestContext(ctx context.Context) *RequestContext {
+	rc, _ := ctx.Value(currentRequestCtxKey).(*RequestContext)
+	return rc
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import "context"
+
+// Resolver is the next step in a field's resolver chain. It is called with
+// no arguments because the field and its arguments are already bound into
+// the closure by the generated resolver.
+type Resolver func(ctx context.Context) (res interface{}, err error)
+
+// DirectiveFunc is a user-registered handler for a schema or query
+// directive. It receives the directive's bound arguments and next, the
+// resolver (or next directive) to call to continue execution, and may
+// short-circuit by returning without calling next.
+type DirectiveFunc func(ctx context.Context, obj interface{}, next Resolver, args map[string]interface{}) (res interface{}, err error)
+
+// ResolverMiddleware wraps next in the chain of directive handlers
+// registered in reqCtx that apply to this field, in the order they appear
+// in the query (the first directive written runs outermost). Directives
+// with no registered handler are skipped.
+func (f CollectedField) ResolverMiddleware(reqCtx *RequestContext, obj interface{}, next Resolver) Resolver {
+	for i := len(f.Directives) - 1; i >= 0; i-- {
+		d := f.Directives[i]
+		handler, ok := reqCtx.Directives[d.Name]
+		if !ok {
+			continue
+		}
+
+		d, handler, wrapped := d, handler, next
+		next = func(ctx context.Context) (interface{}, error) {
+			return handler(ctx, obj, wrapped, d.Args)
+		}
+	}
+
+	return next
+}
+
+// InvokeField runs resolve for f, wrapped with the directive middleware
+// chain and instrumented with reqCtx.Tracer's field hooks. rc is pushed
+// onto ctx for the duration of the call via WithResolverContext, so
+// resolve, directive handlers, and the tracer can all see the current
+// path via GetResolverContext(ctx).Path().
+func (f CollectedField) InvokeField(ctx context.Context, reqCtx *RequestContext, rc *ResolverContext, obj interface{}, resolve Resolver) (interface{}, error) {
+	ctx = WithResolverContext(ctx, rc)
+
+	tracer := reqCtx.Tracer
+	if tracer == nil {
+		tracer = NopTracer{}
+	}
+
+	ctx = tracer.StartFieldExecution(ctx, rc)
+	defer tracer.EndFieldExecution(ctx)
+
+	next := f.ResolverMiddleware(reqCtx, obj, resolve)
+
+	ctx = tracer.StartFieldResolverExecution(ctx, rc)
+	return next(ctx)
+}
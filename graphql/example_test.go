@@ -0,0 +1,43 @@
+package graphql_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ExampleCollectedField_InvokeField shows how a generated per-field
+// resolver wraps its resolver function with InvokeField, so that both the
+// directive middleware chain and the active Tracer's field hooks run
+// around it.
+func ExampleCollectedField_InvokeField() {
+	reqCtx := graphql.NewRequestContext(nil, "{ hello }", nil)
+	reqCtx.Directives["shout"] = func(ctx context.Context, obj interface{}, next graphql.Resolver, args map[string]interface{}) (interface{}, error) {
+		res, err := next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("%v!", res), nil
+	}
+
+	field := graphql.CollectedField{
+		Name: "hello",
+		Directives: []graphql.CollectedDirective{
+			{Name: "shout"},
+		},
+	}
+
+	resolve := func(ctx context.Context) (interface{}, error) {
+		return "hello world", nil
+	}
+
+	rc := &graphql.ResolverContext{Object: "Query", Field: field, ReturnType: "String"}
+	res, err := field.InvokeField(context.Background(), reqCtx, rc, nil, resolve)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(res)
+	// Output: hello world!
+}
@@ -34,20 +34,16 @@ func collectFields(reqCtx *RequestContext, selSet ast.SelectionSet, satisfies []
 					Name:  sel.Name,
 				}
 				if len(sel.Arguments) > 0 {
-					f.Args = map[string]interface{}{}
-					for _, arg := range sel.Arguments {
-						if arg.Value.Kind == ast.Variable {
-							if val, ok := reqCtx.Variables[arg.Value.Raw]; ok {
-								f.Args[arg.Name] = val
-							}
-						} else {
-							var err error
-							f.Args[arg.Name], err = arg.Value.Value(reqCtx.Variables)
-							if err != nil {
-								panic(err)
-							}
-						}
+					f.Args = bindArgumentList(sel.Arguments, reqCtx.Variables)
+				}
+				for _, d := range sel.Directives {
+					if d.Name == "skip" || d.Name == "include" {
+						continue
 					}
+					f.Directives = append(f.Directives, CollectedDirective{
+						Name: d.Name,
+						Args: bindArgumentList(d.Arguments, reqCtx.Variables),
+					})
 				}
 				return f
 			})
@@ -99,9 +95,37 @@ type CollectedField struct {
 	Alias      string
 	Name       string
 	Args       map[string]interface{}
+	Directives []CollectedDirective
 	Selections ast.SelectionSet
 }
 
+// CollectedDirective is a directive applied to a field in the query, with
+// its arguments already bound against the request's variables.
+type CollectedDirective struct {
+	Name string
+	Args map[string]interface{}
+}
+
+// bindArgumentList resolves a list of ast.Arguments into a Go map, looking
+// up variable references in variables.
+func bindArgumentList(arguments ast.ArgumentList, variables map[string]interface{}) map[string]interface{} {
+	args := map[string]interface{}{}
+	for _, arg := range arguments {
+		if arg.Value.Kind == ast.Variable {
+			if val, ok := variables[arg.Value.Raw]; ok {
+				args[arg.Name] = val
+			}
+		} else {
+			var err error
+			args[arg.Name], err = arg.Value.Value(variables)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+	return args
+}
+
 func instanceOf(val string, satisfies []string) bool {
 	for _, s := range satisfies {
 		if val == s {
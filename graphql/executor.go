@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/vektah/gqlparser/parser"
+	"github.com/vektah/gqlparser/validator"
+)
+
+// Execute parses and validates query against es's schema, builds a
+// RequestContext for operationName (or the query's sole operation if
+// operationName is empty), and dispatches it to es. It is the call site
+// generated servers use once a request's query text is known (after any
+// APQ lookup); every step is instrumented with tracer's operation-level
+// hooks, using nanosecond timestamps relative to when parsing starts.
+func Execute(ctx context.Context, es ExecutableSchema, query string, operationName string, variables map[string]interface{}, tracer Tracer) *Response {
+	if tracer == nil {
+		tracer = NopTracer{}
+	}
+
+	ctx = tracer.StartOperationParsing(ctx)
+	doc, err := parser.ParseQuery(&ast.Source{Name: "query", Input: query})
+	if err != nil {
+		return &Response{Errors: gqlerror.List{err}}
+	}
+
+	ctx = tracer.StartOperationValidation(ctx)
+	if errs := validator.Validate(es.Schema(), doc); len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+
+	op := doc.Operations.ForName(operationName)
+	if op == nil {
+		return ErrorResponse(ctx, "operation %q not found", operationName)
+	}
+
+	reqCtx := NewRequestContext(doc, query, variables)
+	reqCtx.Operation = op
+	reqCtx.Tracer = tracer
+	ctx = WithRequestContext(ctx, reqCtx)
+
+	ctx = tracer.StartOperationExecution(ctx)
+	defer tracer.EndOperationExecution(ctx)
+
+	switch op.Operation {
+	case ast.Subscription:
+		next := es.Subscription(ctx, op)
+		return next()
+	case ast.Mutation:
+		return es.Mutation(ctx, op)
+	default:
+		return es.Query(ctx, op)
+	}
+}
@@ -0,0 +1,123 @@
+package relay
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConnectionArgs holds the four standard Relay pagination arguments, parsed
+// and validated from a field's raw argument map.
+type ConnectionArgs struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// ParseConnectionArgs reads first/after/last/before out of args and
+// validates the Relay rule that forward pagination (first/after) and
+// backward pagination (last/before) are mutually exclusive.
+func ParseConnectionArgs(args map[string]interface{}) (ConnectionArgs, error) {
+	var parsed ConnectionArgs
+
+	if v, ok := asInt(args["first"]); ok {
+		parsed.First = &v
+	}
+	if v, ok := args["after"].(string); ok {
+		parsed.After = &v
+	}
+	if v, ok := asInt(args["last"]); ok {
+		parsed.Last = &v
+	}
+	if v, ok := args["before"].(string); ok {
+		parsed.Before = &v
+	}
+
+	forward := parsed.First != nil || parsed.After != nil
+	backward := parsed.Last != nil || parsed.Before != nil
+	if forward && backward {
+		return ConnectionArgs{}, fmt.Errorf("first/after and last/before are mutually exclusive")
+	}
+	if parsed.First != nil && *parsed.First < 0 {
+		return ConnectionArgs{}, fmt.Errorf("first must be a non-negative integer")
+	}
+	if parsed.Last != nil && *parsed.Last < 0 {
+		return ConnectionArgs{}, fmt.Errorf("last must be a non-negative integer")
+	}
+
+	return parsed, nil
+}
+
+// asInt coerces an int-valued argument to int. CollectedField.Args is
+// decoded from either an ast.Value (which yields an int64 for integer
+// literals) or a JSON request variable (which yields a float64), so both
+// are accepted alongside plain int for callers that build args by hand.
+func asInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// Edge pairs a resolved node with its opaque cursor.
+type Edge struct {
+	Cursor string
+	Node   interface{}
+}
+
+// PageInfo reports whether more edges exist beyond the current page, per
+// the Relay connection spec.
+type PageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     string
+	EndCursor       string
+}
+
+// Connection is the standard Relay { edges, pageInfo } shape.
+type Connection struct {
+	Edges    []Edge
+	PageInfo PageInfo
+}
+
+// SliceToConnection builds a Connection from a page of already-fetched
+// items. A bare total count can't tell hasNextPage/hasPreviousPage apart
+// from how far after/before has already advanced, so callers implement
+// cursor pagination by fetching one extra row beyond what args requested
+// (e.g. LIMIT first+1), trimming it off before passing items in here, and
+// reporting whether that extra row existed via hasMore.
+func SliceToConnection(items []interface{}, hasMore bool, args ConnectionArgs, cursorFor func(item interface{}) string) *Connection {
+	edges := make([]Edge, len(items))
+	for i, item := range items {
+		edges[i] = Edge{Cursor: cursorFor(item), Node: item}
+	}
+
+	pageInfo := PageInfo{}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = edges[0].Cursor
+		pageInfo.EndCursor = edges[len(edges)-1].Cursor
+	}
+
+	switch {
+	case args.First != nil:
+		pageInfo.HasNextPage = hasMore
+		pageInfo.HasPreviousPage = args.After != nil
+	case args.Last != nil:
+		pageInfo.HasPreviousPage = hasMore
+		pageInfo.HasNextPage = args.Before != nil
+	}
+
+	return &Connection{Edges: edges, PageInfo: pageInfo}
+}
@@ -0,0 +1,77 @@
+package relay
+
+import "testing"
+
+func TestParseConnectionArgs(t *testing.T) {
+	// first/last as they actually arrive: an integer literal decodes via
+	// ast.Value.Value() to int64, a variable-supplied value decodes via
+	// encoding/json to float64.
+	args, err := ParseConnectionArgs(map[string]interface{}{"first": int64(5)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if args.First == nil || *args.First != 5 {
+		t.Fatalf("expected First == 5, got %v", args.First)
+	}
+
+	args, err = ParseConnectionArgs(map[string]interface{}{"last": float64(10), "before": "cursor"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if args.Last == nil || *args.Last != 10 {
+		t.Fatalf("expected Last == 10, got %v", args.Last)
+	}
+	if args.Before == nil || *args.Before != "cursor" {
+		t.Fatalf("expected Before == \"cursor\", got %v", args.Before)
+	}
+
+	if _, err := ParseConnectionArgs(map[string]interface{}{"first": int64(5), "last": int64(5)}); err == nil {
+		t.Fatal("expected error for mutually exclusive first/last")
+	}
+
+	if _, err := ParseConnectionArgs(map[string]interface{}{"first": int64(-1)}); err == nil {
+		t.Fatal("expected error for negative first")
+	}
+}
+
+func TestSliceToConnection(t *testing.T) {
+	cursorFor := func(item interface{}) string { return EncodeCursor("Widget", item) }
+
+	args, err := ParseConnectionArgs(map[string]interface{}{"first": int64(2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := SliceToConnection([]interface{}{1, 2}, true, args, cursorFor)
+	if !conn.PageInfo.HasNextPage {
+		t.Fatal("expected HasNextPage to be true when the caller reports an extra row beyond the page")
+	}
+	if conn.PageInfo.HasPreviousPage {
+		t.Fatal("expected HasPreviousPage to be false with no after cursor")
+	}
+	if len(conn.Edges) != 2 || conn.Edges[0].Cursor == "" {
+		t.Fatalf("expected 2 edges with cursors, got %+v", conn.Edges)
+	}
+}
+
+// TestSliceToConnectionLastPage reproduces the scenario a flat totalCount
+// gets wrong: 5 total items, first: 3, after: cursor of item 3, so the
+// caller's query returns only items 4 and 5 with no extra row — there is
+// nothing left, regardless of how many items exist overall.
+func TestSliceToConnectionLastPage(t *testing.T) {
+	cursorFor := func(item interface{}) string { return EncodeCursor("Widget", item) }
+
+	after := EncodeCursor("Widget", 3)
+	args, err := ParseConnectionArgs(map[string]interface{}{"first": int64(3), "after": after})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := SliceToConnection([]interface{}{4, 5}, false, args, cursorFor)
+	if conn.PageInfo.HasNextPage {
+		t.Fatal("expected HasNextPage to be false on the last page, regardless of the overall total")
+	}
+	if !conn.PageInfo.HasPreviousPage {
+		t.Fatal("expected HasPreviousPage to be true when after was supplied")
+	}
+}
@@ -0,0 +1,33 @@
+// Package relay provides cursor pagination and global object identification
+// helpers for schemas that follow the Relay connection specification.
+package relay
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// EncodeCursor builds an opaque cursor for id, an instance of typeName, by
+// base64-encoding "typeName:id". The same encoding is used for both edge
+// cursors and global node IDs.
+func EncodeCursor(typeName string, id interface{}) string {
+	raw := fmt.Sprintf("%s:%v", typeName, id)
+	return base64.StdEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor reverses EncodeCursor, returning the type name and id it was
+// built from.
+func DecodeCursor(s string) (typeName string, id string, err error) {
+	raw, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid cursor: %s", err)
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid cursor: missing type separator")
+	}
+
+	return parts[0], parts[1], nil
+}
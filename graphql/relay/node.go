@@ -0,0 +1,40 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+)
+
+// NodeResolverFunc loads a single node by its underlying (non-opaque) id.
+type NodeResolverFunc func(ctx context.Context, id string) (interface{}, error)
+
+// NodeRoot is the registry of per-type node loaders, keyed by GraphQL type
+// name, that backs a schema's global "node(id: ID!)" field. Register a
+// loader for every type implementing the Node interface.
+//
+// Auto-registration isn't wired up yet: there is no codegen in this tree,
+// so nothing recognizes types implementing Node and emits these Register
+// calls automatically. Until that exists, every Node-implementing type
+// needs its loader registered by hand.
+type NodeRoot map[string]NodeResolverFunc
+
+// Register adds resolver as the loader for typeName's nodes.
+func (n NodeRoot) Register(typeName string, resolver NodeResolverFunc) {
+	n[typeName] = resolver
+}
+
+// Resolve decodes globalID and dispatches to the loader registered for its
+// type, per the Relay global object identification spec.
+func (n NodeRoot) Resolve(ctx context.Context, globalID string) (interface{}, error) {
+	typeName, id, err := DecodeCursor(globalID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, ok := n[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no node resolver registered for type %q", typeName)
+	}
+
+	return resolver(ctx, id)
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import "context"
+
+// ResolverContext describes the resolver currently being invoked. It nests
+// via Parent, letting Path() reconstruct the full response path (e.g.
+// []interface{}{"user", "posts", 3, "title"}) for error reporting and
+// tracing.
+type ResolverContext struct {
+	Parent *ResolverContext
+	// Object is the name of the type the current field belongs to.
+	Object string
+	// Field is the CollectedField being resolved.
+	Field CollectedField
+	// ReturnType is the GraphQL type name the field resolves to.
+	ReturnType string
+	// Index is set when the current field is an element of a list.
+	Index *int
+}
+
+type resolverCtxKey string
+
+const currentResolverCtxKey resolverCtxKey = "resolver_context"
+
+// WithResolverContext returns a context carrying rc, nested under whatever
+// ResolverContext ctx already carries.
+func WithResolverContext(ctx context.Context, rc *ResolverContext) context.Context {
+	rc.Parent = GetResolverContext(ctx)
+	return context.WithValue(ctx, currentResolverCtxKey, rc)
+}
+
+// GetResolverContext returns the ResolverContext stored in ctx, or nil if
+// there isn't one.
+func GetResolverContext(ctx context.Context) *ResolverContext {
+	rc, _ := ctx.Value(currentResolverCtxKey).(*ResolverContext)
+	return rc
+}
+
+// Path walks up through Parent to build the response path to the current
+// field.
+func (r *ResolverContext) Path() []interface{} {
+	if r == nil {
+		return nil
+	}
+
+	var path []interface{}
+	for cur := r; cur != nil; cur = cur.Parent {
+		if cur.Index != nil {
+			path = append(path, *cur.Index)
+		}
+		if cur.Field.Name != "" {
+			path = append(path, cur.Field.Alias)
+		}
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
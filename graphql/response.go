@@ -0,0 +1,25 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// Response is the result of running a single operation: either Data or a
+// non-empty Errors list is populated, and Extensions carries opt-in
+// side-channel data such as tracing spans.
+type Response struct {
+	Data       json.RawMessage        `json:"data,omitempty"`
+	Errors     gqlerror.List          `json:"errors,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// ErrorResponse builds a Response carrying a single formatted error and no
+// data, for use when an operation is rejected before it runs.
+func ErrorResponse(ctx context.Context, messagef string, args ...interface{}) *Response {
+	return &Response{
+		Errors: gqlerror.List{gqlerror.Errorf(messagef, args...)},
+	}
+}
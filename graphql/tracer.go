@@ -0,0 +1,34 @@
+package graphql
+
+import "context"
+
+// Tracer lets external tools (OpenTracing, Zipkin, Jaeger, Apollo Tracing)
+// observe the lifecycle of an operation as it is parsed, validated and
+// executed. Each Start hook may return a derived context that later hooks
+// and resolvers will see.
+type Tracer interface {
+	StartOperationParsing(ctx context.Context) context.Context
+	StartOperationValidation(ctx context.Context) context.Context
+	StartOperationExecution(ctx context.Context) context.Context
+	StartFieldExecution(ctx context.Context, rc *ResolverContext) context.Context
+	StartFieldResolverExecution(ctx context.Context, rc *ResolverContext) context.Context
+	EndFieldExecution(ctx context.Context)
+	EndOperationExecution(ctx context.Context)
+}
+
+// NopTracer is the default Tracer: every hook is a no-op.
+type NopTracer struct{}
+
+var _ Tracer = NopTracer{}
+
+func (NopTracer) StartOperationParsing(ctx context.Context) context.Context    { return ctx }
+func (NopTracer) StartOperationValidation(ctx context.Context) context.Context { return ctx }
+func (NopTracer) StartOperationExecution(ctx context.Context) context.Context  { return ctx }
+func (NopTracer) StartFieldExecution(ctx context.Context, rc *ResolverContext) context.Context {
+	return ctx
+}
+func (NopTracer) StartFieldResolverExecution(ctx context.Context, rc *ResolverContext) context.Context {
+	return ctx
+}
+func (NopTracer) EndFieldExecution(ctx context.Context)     {}
+func (NopTracer) EndOperationExecution(ctx context.Context) {}